@@ -4,6 +4,7 @@ import (
 	"github.com/recombinant/go-geodesy"
 	"github.com/stretchr/testify/assert"
 	"math"
+	"sync"
 	"testing"
 )
 
@@ -89,6 +90,13 @@ func TestPassParseDMS(t *testing.T) {
 		// Out of range (is tested both positive and negative)
 		{"185", 185.0},
 		{"365", 365.0},
+		{"400°", 400.0},
+	}
+	dataSliceOverflow := []resultLookup{
+		// Minutes or seconds ≥ 60 are currently accepted and carried into the next field; once the
+		// library has an error-returning parser these should become ErrOutOfRange failures
+		{"45°75′", 46.25},
+		{"45°45′75″", 45.770833333333336},
 	}
 
 	t.Run("Parse zero",
@@ -103,6 +111,10 @@ func TestPassParseDMS(t *testing.T) {
 		func(t *testing.T) {
 			variations(&dataSliceOutOfRange, t)
 		})
+	t.Run("Parse minute/second overflow",
+		func(t *testing.T) {
+			variations(&dataSliceOverflow, t)
+		})
 
 }
 
@@ -151,6 +163,45 @@ func variations(dataSlice *[]resultLookup, t *testing.T) {
 func TestFailParseDMS(t *testing.T) {
 	assert.True(t, math.IsNaN(geodesy.ParseDMS("0 0 0 0")))
 	assert.True(t, math.IsNaN(geodesy.ParseDMS("xxx")))
+
+	// too many fields
+	assert.True(t, math.IsNaN(geodesy.ParseDMS("45°45′45″45")))
+	// invalid symbol or compass direction
+	assert.True(t, math.IsNaN(geodesy.ParseDMS("45x")))
+	assert.True(t, math.IsNaN(geodesy.ParseDMS("45° 45′ 45″ X")))
+	assert.True(t, math.IsNaN(geodesy.ParseDMS("45°45′45.36″NE")))
+	// malformed number
+	assert.True(t, math.IsNaN(geodesy.ParseDMS("--45")))
+	assert.True(t, math.IsNaN(geodesy.ParseDMS("45.5.5")))
+	assert.True(t, math.IsNaN(geodesy.ParseDMS("1e5")))
+	// nothing to parse
+	assert.True(t, math.IsNaN(geodesy.ParseDMS("")))
+	assert.True(t, math.IsNaN(geodesy.ParseDMS(" ")))
+	assert.True(t, math.IsNaN(geodesy.ParseDMS("N")))
+}
+
+// Run with -race: parsing and compass lookups must be safe for concurrent use.
+func TestConcurrentParseDMS(t *testing.T) {
+	dataSlice := []resultLookup{
+		{"45°45′45.36″", 45.76260},
+		{"45° 45.756′ S", -45.76260},
+		{"-45.76260", -45.76260},
+		{"000° 00′ 00.0″", 0.0},
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				data := dataSlice[i%len(dataSlice)]
+				assert.Equal(t, geodesy.ParseDMS(data.s), data.f, data.s)
+				assert.Equal(t, geodesy.CompassPoint1(237), "WSW")
+			}
+		}()
+	}
+	wg.Wait()
 }
 
 func TestToDMS(t *testing.T) {
@@ -204,6 +255,21 @@ func TestToDMS(t *testing.T) {
 		})
 }
 
+func TestSeparator(t *testing.T) {
+	old := geodesy.Separator
+	geodesy.Separator = "\u202f" // narrow no-break space
+	defer func() { geodesy.Separator = old }()
+
+	assert.Equal(t, *geodesy.ToDMS2(45.76260, geodesy.FmtDM), "045°\u202f45.76′")
+	assert.Equal(t, *geodesy.ToDMS2(45.76260, geodesy.FmtDMS), "045°\u202f45′\u202f45″")
+	assert.Equal(t, *geodesy.ToDMS2(45.76260, geodesy.FmtD), "045.7626°") // nothing to separate
+	assert.Equal(t, geodesy.ToLat2(51.2, geodesy.FmtDMS), "51°\u202f12′\u202f00″\u202fN")
+	assert.Equal(t, geodesy.ToLon2(0.33, geodesy.FmtDMS), "000°\u202f19′\u202f48″\u202fE")
+
+	// separated output still parses
+	assert.Equal(t, geodesy.ParseDMS(geodesy.ToLat2(51.2, geodesy.FmtDMS)), 51.2)
+}
+
 func TestCompass(t *testing.T) {
 	assert.Equal(t, geodesy.CompassPoint1(1.0), "N")
 	assert.Equal(t, geodesy.CompassPoint1(0), "N")
@@ -223,6 +289,35 @@ func TestCompass(t *testing.T) {
 	assert.Equal(t, geodesy.CompassPoint2(237, geodesy.SecondaryInterCardinalPrecision), "WSW")
 }
 
+func TestCompassPointAllocs(t *testing.T) {
+	var point string
+	allocs := testing.AllocsPerRun(100, func() {
+		point = geodesy.CompassPoint2(237, geodesy.SecondaryInterCardinalPrecision)
+	})
+	assert.Equal(t, allocs, 0.0)
+	assert.Equal(t, point, "WSW")
+}
+
+func TestCompassBoundaries(t *testing.T) {
+	// sectors are centred on each compass point; a bearing on a boundary rounds clockwise
+	assert.Equal(t, geodesy.CompassPoint1(11.24), "N")
+	assert.Equal(t, geodesy.CompassPoint1(11.25), "NNE")
+	assert.Equal(t, geodesy.CompassPoint1(348.74), "NNW")
+	assert.Equal(t, geodesy.CompassPoint1(348.75), "N")
+	assert.Equal(t, geodesy.CompassPoint2(22.49, geodesy.InterCardinalPrecision), "N")
+	assert.Equal(t, geodesy.CompassPoint2(22.5, geodesy.InterCardinalPrecision), "NE")
+	assert.Equal(t, geodesy.CompassPoint2(44.99, geodesy.CardinalPrecision), "N")
+	assert.Equal(t, geodesy.CompassPoint2(45, geodesy.CardinalPrecision), "E")
+	assert.Equal(t, geodesy.CompassPoint2(315, geodesy.CardinalPrecision), "N")
+
+	// every secondary-intercardinal point maps back to itself
+	points := []string{"N", "NNE", "NE", "ENE", "E", "ESE", "SE", "SSE", "S", "SSW", "SW", "WSW", "W", "WNW", "NW", "NNW"}
+	for i, point := range points {
+		bearing := float64(i) * 22.5
+		assert.Equal(t, geodesy.CompassPoint1(bearing), point, bearing)
+	}
+}
+
 func TestToLatLon(t *testing.T) {
 	t.Run("toLat",
 		func(t *testing.T) {
@@ -241,3 +336,79 @@ func TestToLatLon(t *testing.T) {
 			assert.Equal(t, geodesy.ToBrng(math.NaN(), geodesy.FmtDMS, 2), "-")
 		})
 }
+
+// Benchmarks for the formatting functions, reporting allocations per call.
+
+var benchmarkStringSink string
+
+func BenchmarkToDMS(b *testing.B) {
+	benchmarks := []struct {
+		name   string
+		format geodesy.DmsFormat
+		dp     uint
+	}{
+		{"d", geodesy.FmtD, 0},
+		{"d dp6", geodesy.FmtD, 6},
+		{"dm", geodesy.FmtDM, 0},
+		{"dm dp4", geodesy.FmtDM, 4},
+		{"dms", geodesy.FmtDMS, 0},
+		{"dms dp2", geodesy.FmtDMS, 2},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				benchmarkStringSink = *geodesy.ToDMS3(45.76260, bm.format, bm.dp)
+			}
+		})
+	}
+}
+
+func BenchmarkToLat(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchmarkStringSink = geodesy.ToLat3(51.2, geodesy.FmtDMS, 0)
+	}
+}
+
+func BenchmarkToLon(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchmarkStringSink = geodesy.ToLon3(0.33, geodesy.FmtDMS, 0)
+	}
+}
+
+func BenchmarkToBrng(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchmarkStringSink = geodesy.ToBrng(359.9999999999999, geodesy.FmtDMS, 0)
+	}
+}
+
+func BenchmarkCompassPoint(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchmarkStringSink = geodesy.CompassPoint2(237, geodesy.SecondaryInterCardinalPrecision)
+	}
+}
+
+var benchmarkFloatSink float64
+
+func BenchmarkParseDMS(b *testing.B) {
+	benchmarks := []struct {
+		name, s string
+	}{
+		{"d", "45.76260"},
+		{"dm", "45° 45.756′ N"},
+		{"dms", "45° 45′ 45.36″ W"},
+		{"fail", "0 0 0 0"},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				benchmarkFloatSink = geodesy.ParseDMS(bm.s)
+			}
+		})
+	}
+}
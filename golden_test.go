@@ -0,0 +1,173 @@
+package geodesy_test
+
+import (
+	"encoding/json"
+	"flag"
+	"github.com/recombinant/go-geodesy"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -  */
+/*  Golden test vectors                                                                           */
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -  */
+
+// Reference values produced by the library are kept in testdata/golden.json, so that downstream
+// projects can validate their own integrations against the same numbers.
+//
+// The vectors are a snapshot of this library's output, not an independent computation; only the
+// OS guide C1/C2 pairs are cross-checked against published figures (see assertPublishedVectors).
+//
+// Regenerate after a deliberate change in results with:
+//
+//	go test -run TestGolden -update
+var update = flag.Bool("update", false, "update golden files in testdata")
+
+var goldenFile = filepath.Join("testdata", "golden.json")
+
+var goldenDatums = map[string]*geodesy.Datum{
+	"WGS84":  geodesy.WGS84,
+	"OSGB36": geodesy.OSGB36,
+}
+
+type goldenParseDMS struct {
+	Input   string  `json:"input"`
+	Degrees float64 `json:"degrees"`
+}
+
+type goldenToDMS struct {
+	Degrees float64 `json:"degrees"`
+	Format  string  `json:"format"`
+	Dp      uint    `json:"dp"`
+	Output  string  `json:"output"`
+}
+
+type goldenOsGrid struct {
+	Lat      float64 `json:"lat"`
+	Lon      float64 `json:"lon"`
+	Datum    string  `json:"datum"`
+	Easting  float64 `json:"easting"`
+	Northing float64 `json:"northing"`
+}
+
+type goldenOsGridToLatLon struct {
+	Easting  float64 `json:"easting"`
+	Northing float64 `json:"northing"`
+	Datum    string  `json:"datum"`
+	LatLon   string  `json:"latLon"`
+}
+
+type goldenVectors struct {
+	ParseDMS       []goldenParseDMS       `json:"parseDMS"`
+	ToDMS          []goldenToDMS          `json:"toDMS"`
+	OsGrid         []goldenOsGrid         `json:"osGrid"`
+	OsGridToLatLon []goldenOsGridToLatLon `json:"osGridToLatLon"`
+}
+
+// OS Guide to coordinate systems in Great Britain C.1, C.2; Caister water tower
+var (
+	osGuideLat = geodesy.ParseDMS("52°39′27.2531″N")
+	osGuideLon = geodesy.ParseDMS("1°43′4.5177″E")
+)
+
+const (
+	osGuideEasting  = 651409.903
+	osGuideNorthing = 313177.270
+	osGuideLatLon   = "52°39′27.2531″N, 001°43′04.5177″E"
+)
+
+func generateGoldenVectors() goldenVectors {
+	var vectors goldenVectors
+
+	for _, s := range []string{
+		"51° 28′ 40.12″ N",
+		"000° 00′ 05.31″ W",
+		"45°45.756′",
+		"-45.76260",
+		"52°39′27.2531″N",
+		"1°43′4.5177″E",
+	} {
+		vectors.ParseDMS = append(vectors.ParseDMS, goldenParseDMS{s, geodesy.ParseDMS(s)})
+	}
+
+	formats := []struct {
+		name   string
+		format geodesy.DmsFormat
+	}{
+		{"d", geodesy.FmtD},
+		{"dm", geodesy.FmtDM},
+		{"dms", geodesy.FmtDMS},
+	}
+	for _, deg := range []float64{0, 45.76260, 51.19999999999999, 179.9999} {
+		for _, f := range formats {
+			for _, dp := range []uint{0, 2, 4} {
+				vectors.ToDMS = append(vectors.ToDMS, goldenToDMS{deg, f.name, dp, *geodesy.ToDMS3(deg, f.format, dp)})
+			}
+		}
+	}
+
+	for _, p := range []struct {
+		lat, lon float64
+		datum    string
+	}{
+		{osGuideLat, osGuideLon, "OSGB36"}, // OS guide C1
+		{52.65798, 1.71605, "WGS84"},
+		{51.4778, -0.0016, "WGS84"}, // Greenwich
+		{57.80, -5.50, "WGS84"},
+	} {
+		point := geodesy.LatLon{Lat: p.lat, Lon: p.lon, Datum: goldenDatums[p.datum]}
+		gridref := point.LatLonToOsGrid()
+		vectors.OsGrid = append(vectors.OsGrid, goldenOsGrid{
+			p.lat, p.lon, p.datum, geodesy.ToFixed(gridref.Easting, 3), geodesy.ToFixed(gridref.Northing, 3),
+		})
+	}
+
+	for _, g := range []struct {
+		easting, northing float64
+		datum             string
+	}{
+		{osGuideEasting, osGuideNorthing, "OSGB36"}, // OS guide C2
+		{osGuideEasting, osGuideNorthing, "WGS84"},
+		{544359, 180653, "OSGB36"}, // TQ 44359 80653
+	} {
+		gridref := geodesy.NewOsGridRef(g.easting, g.northing)
+		latLon := gridref.OsGridToLatLon(goldenDatums[g.datum]).ToString(geodesy.FmtDMS, 4)
+		vectors.OsGridToLatLon = append(vectors.OsGridToLatLon, goldenOsGridToLatLon{g.easting, g.northing, g.datum, latLon})
+	}
+
+	return vectors
+}
+
+// assertPublishedVectors checks the generated vectors against independently published values, so
+// that -update cannot silently record a wrong result for them.
+func assertPublishedVectors(t *testing.T, vectors goldenVectors) bool {
+	ok := assert.Contains(t, vectors.OsGrid,
+		goldenOsGrid{osGuideLat, osGuideLon, "OSGB36", osGuideEasting, osGuideNorthing}, "OS guide C1")
+	ok = assert.Contains(t, vectors.OsGridToLatLon,
+		goldenOsGridToLatLon{osGuideEasting, osGuideNorthing, "OSGB36", osGuideLatLon}, "OS guide C2") && ok
+	return ok
+}
+
+func TestGolden(t *testing.T) {
+	vectors := generateGoldenVectors()
+
+	if !assertPublishedVectors(t, vectors) {
+		return
+	}
+
+	if *update {
+		data, err := json.MarshalIndent(vectors, "", "  ")
+		assert.NoError(t, err)
+		assert.NoError(t, os.WriteFile(goldenFile, append(data, '\n'), 0644))
+	}
+
+	data, err := os.ReadFile(goldenFile)
+	if !assert.NoError(t, err) {
+		return
+	}
+	var expected goldenVectors
+	assert.NoError(t, json.Unmarshal(data, &expected))
+	assert.Equal(t, vectors, expected)
+}
@@ -0,0 +1,32 @@
+package geodesy_test
+
+import (
+	"github.com/recombinant/go-geodesy"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -  */
+/*  Geodesy Test Harness - latlon-ellipsoidal                         (c) Chris Veness 2014-2017  */
+/* - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -  */
+
+func TestLatLonToString(t *testing.T) {
+	greenwich := geodesy.NewLatLon(51.47788, -0.00147)
+	t.Run("toString",
+		func(t *testing.T) {
+			assert.Equal(t, greenwich.ToString(geodesy.FmtD, 4), "51.4779°N, 000.0015°W")
+			assert.Equal(t, greenwich.ToString(geodesy.FmtDM, 2), "51°28.67′N, 000°00.09′W")
+			assert.Equal(t, greenwich.ToString(geodesy.FmtDMS, 0), "51°28′40″N, 000°00′05″W")
+			assert.Equal(t, greenwich.ToString(geodesy.FmtDMS, 2), "51°28′40.37″N, 000°00′05.29″W")
+		})
+	t.Run("toString round-trip",
+		func(t *testing.T) {
+			// no ParseLatLon yet: parse each component of the pair individually
+			s := greenwich.ToString(geodesy.FmtDMS, 4)
+			assert.Equal(t, s, "51°28′40.3680″N, 000°00′05.2920″W")
+			latLon := strings.Split(s, ", ")
+			assert.Equal(t, geodesy.ToFixed(geodesy.ParseDMS(latLon[0]), 8), 51.47788)
+			assert.Equal(t, geodesy.ToFixed(geodesy.ParseDMS(latLon[1]), 8), -0.00147)
+		})
+}
@@ -19,6 +19,59 @@ func TestOsGridRef(t *testing.T) {
 			osgb2 := gridref.OsGridToLatLon(geodesy.OSGB36)
 			assert.Equal(t, osgb2.ToString(geodesy.FmtDMS, 4), "52°39′27.2531″N, 001°43′04.5177″E")
 		})
+	t.Run("C2",
+		func(t *testing.T) {
+			gridref := geodesy.NewOsGridRef(651409.903, 313177.270)
+			osgb := gridref.OsGridToLatLon(geodesy.OSGB36)
+			assert.Equal(t, osgb.ToString(geodesy.FmtDMS, 4), "52°39′27.2531″N, 001°43′04.5177″E")
+			gridref2 := osgb.LatLonToOsGrid()
+			assert.Equal(t, geodesy.ToFixed(gridref2.Easting, 3), 651409.903)
+			assert.Equal(t, geodesy.ToFixed(gridref2.Northing, 3), 313177.270)
+		})
+	t.Run("limits numeric",
+		func(t *testing.T) {
+			assert.Equal(t, geodesy.NewOsGridRef(0, 0).ToString(0), "000000,000000")
+			assert.Equal(t, geodesy.NewOsGridRef(699999, 1299999).ToString(0), "699999,1299999") // note 7-digit N
+		})
+	t.Run("DG round-trip OSGB36",
+		func(t *testing.T) {
+			dgGridRef := geodesy.NewOsGridRef(544359, 180653) // TQ 44359 80653
+			assert.Equal(t, dgGridRef.ToString(0), "544359,180653")
+
+			// round-tripping OSGB36 works perfectly
+			dgOsgb := dgGridRef.OsGridToLatLon(geodesy.OSGB36)
+			gridref := dgOsgb.LatLonToOsGrid()
+			assert.Equal(t, geodesy.ToFixed(gridref.Easting, 3), 544359.0)
+			assert.Equal(t, geodesy.ToFixed(gridref.Northing, 3), 180653.0)
+		})
+	t.Run("DG round-trip WGS84",
+		func(t *testing.T) {
+			// reversing Helmert transform (OSGB->WGS->OSGB) introduces small error (≈ 3mm in UK), so WGS84
+			// round-trip is not quite perfect: test needs to incorporate 3mm error to pass
+			dgGridRef := geodesy.NewOsGridRef(544359, 180653)
+			dgWgs := dgGridRef.OsGridToLatLon(geodesy.WGS84)
+			gridref := dgWgs.LatLonToOsGrid()
+			assert.Equal(t, geodesy.ToFixed(gridref.Easting, 3), 544358.997)
+			assert.Equal(t, geodesy.ToFixed(gridref.Northing, 3), 180653.0)
+		})
+}
+
+func TestDatum(t *testing.T) {
+	t.Run("C1 WGS84",
+		func(t *testing.T) {
+			gridref := geodesy.NewOsGridRef(651409.903, 313177.270)
+			assert.Equal(t, gridref.OsGridToLatLon(geodesy.WGS84).ToString(geodesy.FmtDMS, 3), "52°39′28.723″N, 001°42′57.787″E")
+			assert.Equal(t, gridref.OsGridToLatLon(nil).ToString(geodesy.FmtDMS, 3), "52°39′28.723″N, 001°42′57.787″E") // default is WGS84
+		})
+	t.Run("convert WGS84 <-> OSGB36",
+		func(t *testing.T) {
+			greenwichWGS84 := geodesy.NewLatLon(51.4778, -0.0016) // default WGS84
+			assert.Equal(t, greenwichWGS84.Datum, geodesy.WGS84)
+			gridref := greenwichWGS84.LatLonToOsGrid()
+			greenwichOSGB36 := gridref.OsGridToLatLon(geodesy.OSGB36)
+			assert.Equal(t, greenwichOSGB36.ToString(geodesy.FmtD, 4), "51.4773°N, 000.0000°E")
+			assert.Equal(t, gridref.OsGridToLatLon(geodesy.WGS84).ToString(geodesy.FmtD, 4), "51.4778°N, 000.0016°W")
+		})
 }
 
 //describe("os-gridref', function() {